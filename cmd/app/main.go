@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gopherd/core/service"
+
+	_ "github.com/gopherd/example/components/auth"
+	_ "github.com/gopherd/example/components/blockexit"
+	_ "github.com/gopherd/example/components/cache"
+	"github.com/gopherd/example/components/config"
+	_ "github.com/gopherd/example/components/cron"
+	_ "github.com/gopherd/example/components/db"
+	_ "github.com/gopherd/example/components/eventsystem"
+	_ "github.com/gopherd/example/components/httpserver"
+	_ "github.com/gopherd/example/components/jobs"
+	_ "github.com/gopherd/example/components/logger"
+	_ "github.com/gopherd/example/components/users"
+
+	// db's Driver option defaults to mysql-style DSNs (see buildDSN), so this is the
+	// driver actually exercised by sql.Open when the db component is configured
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/gopherd/example/runmode"
+)
+
+func main() {
+	mode := flag.String("mode", "all", "run mode: api|cron|job|all")
+	flag.Parse()
+
+	switch runmode.Mode(*mode) {
+	case runmode.API, runmode.Cron, runmode.Job, runmode.All:
+		runmode.Set(runmode.Mode(*mode))
+	default:
+		fmt.Fprintf(os.Stderr, "unknown mode %q, expected one of: api, cron, job, all\n", *mode)
+		os.Exit(2)
+	}
+
+	// 把配置文件、同目录 .env 和 APP__ 环境变量合并成一份 JSON 再交给 service.Run，
+	// 这样 -mode 选择的所有组件仍然共享同一份（已经叠加过覆盖值的）配置
+	if err := config.Preprocess(); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %v\n", err)
+		os.Exit(2)
+	}
+
+	service.Run()
+}
@@ -2,13 +2,19 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
 	"net/http"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/gopherd/core/component"
 	"github.com/gopherd/core/event"
 	"github.com/gopherd/example/components/auth/authapi"
 	"github.com/gopherd/example/components/httpserver/httpserverapi"
+	"github.com/gopherd/example/runmode"
 )
 
 const name = "github.com/gopherd/example/components/auth"
@@ -23,26 +29,112 @@ func init() {
 
 type authComponent struct {
 	component.BaseComponentWithRefs[struct {
-		Secret string
+		Secret   string
+		TokenTTL time.Duration
+		Users    []UserConfig
 	}, struct {
 		HTTPServer  component.Reference[httpserverapi.Component]
 		EventSystem component.Reference[event.Dispatcher[reflect.Type]]
 	}]
+	users    UserStore
+	sessions *memorySessionStore
+}
+
+func (a *authComponent) Init(ctx context.Context) error {
+	a.users = newMemoryUserStore(a.Options().Users)
+	a.sessions = newMemorySessionStore(time.Minute)
+	return nil
+}
+
+func (a *authComponent) Shutdown(ctx context.Context) error {
+	a.sessions.Close()
+	return nil
 }
 
 func (a *authComponent) Start(ctx context.Context) error {
+	if !runmode.Enabled(runmode.API) {
+		a.Logger().Info("Auth component disabled in current run mode")
+		return nil
+	}
 	a.Logger().Info("Starting Auth component")
-	a.Refs().HTTPServer.Component().HandleFunc("/login", a.handleLogin)
+	a.Refs().HTTPServer.Component().POST("/login", a.handleLogin)
+	g := a.Refs().HTTPServer.Component().Group("")
+	g.Use(a.Middleware())
+	g.POST("/logout", a.handleLogout)
 	return nil
 }
 
 func (a *authComponent) handleLogin(w http.ResponseWriter, r *http.Request) {
 	username := r.FormValue("username")
-	// 简单的认证逻辑，实际应用中应该更加安全
-	if username != "" {
-		a.Refs().EventSystem.Component().DispatchEvent(context.Background(), &authapi.LoginEvent{Username: username})
-		w.Write([]byte("Login successful"))
-	} else {
-		http.Error(w, "Invalid username", http.StatusBadRequest)
+	password := r.FormValue("password")
+	if username == "" || password == "" || !a.users.Verify(username, password) {
+		http.Error(w, "Invalid username or password", http.StatusUnauthorized)
+		return
+	}
+
+	ttl := a.Options().TokenTTL
+	if ttl <= 0 {
+		ttl = time.Hour
+	}
+	now := time.Now()
+	jti := generateJTI()
+	claims := jwtClaims{
+		Sub: username,
+		Iat: now.Unix(),
+		Exp: now.Add(ttl).Unix(),
+		JTI: jti,
+	}
+	token, err := signJWT([]byte(a.Options().Secret), claims)
+	if err != nil {
+		a.Logger().Error("Failed to issue token", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	a.sessions.Add(jti, time.Unix(claims.Exp, 0))
+
+	a.Refs().EventSystem.Component().DispatchEvent(r.Context(), &authapi.LoginEvent{Username: username})
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"token": token})
+}
+
+// handleLogout 吊销当前请求携带的 jti，使该 token 立即失效
+func (a *authComponent) handleLogout(w http.ResponseWriter, r *http.Request) {
+	principal, ok := authapi.PrincipalFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	a.sessions.Revoke(principal.JTI)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Middleware 解析 `Authorization: Bearer <token>`，校验签名、过期时间和吊销状态，
+// 通过后把 *authapi.Principal 注入 request context
+func (a *authComponent) Middleware() func(http.Handler) http.Handler {
+	const prefix = "Bearer "
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			if !strings.HasPrefix(header, prefix) {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			claims, err := parseJWT([]byte(a.Options().Secret), strings.TrimPrefix(header, prefix))
+			if err != nil || !a.sessions.Active(claims.JTI) {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			principal := &authapi.Principal{Username: claims.Sub, JTI: claims.JTI}
+			next.ServeHTTP(w, r.WithContext(authapi.ContextWithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+func generateJTI() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
 	}
+	return hex.EncodeToString(buf)
 }
@@ -2,14 +2,35 @@ package authapi
 
 import (
 	"context"
+	"net/http"
 	"reflect"
 
 	"github.com/gopherd/core/event"
 )
 
 type Component interface {
-	// 如果有的话，可以在这里定义 Auth 组件的公共方法
-	// 如果没有，则可以不定义这个接口
+	// Middleware 返回一个校验 `Authorization: Bearer <token>` 的中间件，
+	// 校验通过后会把 *Principal 注入到 request context 中
+	Middleware() func(http.Handler) http.Handler
+}
+
+// Principal 是通过身份校验后，附着在请求 context 上的主体信息
+type Principal struct {
+	Username string
+	JTI      string
+}
+
+type principalKey struct{}
+
+// ContextWithPrincipal 返回一个携带 principal 的新 context，由 Middleware 在校验通过后调用
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext 从 context 中取出当前请求的 principal
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
 }
 
 // 事件也可以定义在这里，或者项目中可以集中定义事件
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSignAndParseJWTRoundTrip(t *testing.T) {
+	secret := []byte("test-secret")
+	claims := jwtClaims{
+		Sub: "alice",
+		Iat: time.Now().Unix(),
+		Exp: time.Now().Add(time.Hour).Unix(),
+		JTI: "abc123",
+	}
+
+	token, err := signJWT(secret, claims)
+	if err != nil {
+		t.Fatalf("signJWT() error = %v", err)
+	}
+
+	got, err := parseJWT(secret, token)
+	if err != nil {
+		t.Fatalf("parseJWT() error = %v", err)
+	}
+	if got != claims {
+		t.Fatalf("parseJWT() = %+v, want %+v", got, claims)
+	}
+}
+
+func TestParseJWTRejectsExpiredToken(t *testing.T) {
+	secret := []byte("test-secret")
+	claims := jwtClaims{
+		Sub: "alice",
+		Iat: time.Now().Add(-2 * time.Hour).Unix(),
+		Exp: time.Now().Add(-time.Hour).Unix(),
+		JTI: "abc123",
+	}
+	token, err := signJWT(secret, claims)
+	if err != nil {
+		t.Fatalf("signJWT() error = %v", err)
+	}
+	if _, err := parseJWT(secret, token); err != errInvalidToken {
+		t.Fatalf("parseJWT() error = %v, want %v", err, errInvalidToken)
+	}
+}
+
+func TestParseJWTRejectsTamperedSignature(t *testing.T) {
+	secret := []byte("test-secret")
+	claims := jwtClaims{Sub: "alice", Exp: time.Now().Add(time.Hour).Unix(), JTI: "abc123"}
+	token, err := signJWT(secret, claims)
+	if err != nil {
+		t.Fatalf("signJWT() error = %v", err)
+	}
+	if _, err := parseJWT([]byte("wrong-secret"), token); err != errInvalidToken {
+		t.Fatalf("parseJWT() error = %v, want %v", err, errInvalidToken)
+	}
+}
+
+func TestParseJWTRejectsMalformedToken(t *testing.T) {
+	if _, err := parseJWT([]byte("secret"), "not-a-jwt"); err != errInvalidToken {
+		t.Fatalf("parseJWT() error = %v, want %v", err, errInvalidToken)
+	}
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// SessionStore 跟踪已签发且未被吊销的 jti，其方法集允许未来接入 Redis 等共享存储实现
+type SessionStore interface {
+	// Add 记录一个新签发的 jti，expiresAt 到期后该 jti 自动视为失效
+	Add(jti string, expiresAt time.Time)
+	// Revoke 主动吊销一个 jti，用于登出场景
+	Revoke(jti string)
+	// Active 返回 jti 是否仍然有效（已签发、未吊销且未过期）
+	Active(jti string) bool
+}
+
+// memorySessionStore 是带 TTL 定期清理的内存实现
+type memorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]time.Time
+	stop     chan struct{}
+}
+
+func newMemorySessionStore(sweepInterval time.Duration) *memorySessionStore {
+	if sweepInterval <= 0 {
+		sweepInterval = time.Minute
+	}
+	s := &memorySessionStore{
+		sessions: make(map[string]time.Time),
+		stop:     make(chan struct{}),
+	}
+	go s.sweepLoop(sweepInterval)
+	return s
+}
+
+func (s *memorySessionStore) sweepLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.sweep()
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+func (s *memorySessionStore) sweep() {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for jti, expiresAt := range s.sessions {
+		if now.After(expiresAt) {
+			delete(s.sessions, jti)
+		}
+	}
+}
+
+func (s *memorySessionStore) Add(jti string, expiresAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[jti] = expiresAt
+}
+
+func (s *memorySessionStore) Revoke(jti string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, jti)
+}
+
+func (s *memorySessionStore) Active(jti string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiresAt, ok := s.sessions[jti]
+	return ok && time.Now().Before(expiresAt)
+}
+
+// Close 停止后台清理协程
+func (s *memorySessionStore) Close() {
+	close(s.stop)
+}
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemorySessionStoreRevoke(t *testing.T) {
+	s := newMemorySessionStore(time.Minute)
+	defer s.Close()
+
+	s.Add("jti-1", time.Now().Add(time.Hour))
+	if !s.Active("jti-1") {
+		t.Fatal("expected jti-1 to be active after Add")
+	}
+
+	s.Revoke("jti-1")
+	if s.Active("jti-1") {
+		t.Fatal("expected jti-1 to be inactive after Revoke")
+	}
+}
+
+func TestMemorySessionStoreActiveRejectsExpired(t *testing.T) {
+	s := newMemorySessionStore(time.Minute)
+	defer s.Close()
+
+	s.Add("jti-1", time.Now().Add(-time.Second))
+	if s.Active("jti-1") {
+		t.Fatal("expected an already-expired jti to be inactive")
+	}
+}
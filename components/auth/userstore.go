@@ -0,0 +1,35 @@
+package auth
+
+import "golang.org/x/crypto/bcrypt"
+
+// UserConfig 是从配置中加载的一条用户记录，Password 是 bcrypt 哈希而非明文
+type UserConfig struct {
+	Username string
+	Password string
+}
+
+// UserStore 校验用户名密码，其方法集允许未来接入数据库/第三方账号体系的实现
+type UserStore interface {
+	Verify(username, password string) bool
+}
+
+// memoryUserStore 是用配置中的用户列表初始化出来的内存实现
+type memoryUserStore struct {
+	passwordHashes map[string]string
+}
+
+func newMemoryUserStore(users []UserConfig) *memoryUserStore {
+	hashes := make(map[string]string, len(users))
+	for _, u := range users {
+		hashes[u.Username] = u.Password
+	}
+	return &memoryUserStore{passwordHashes: hashes}
+}
+
+func (s *memoryUserStore) Verify(username, password string) bool {
+	hash, ok := s.passwordHashes[username]
+	if !ok {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
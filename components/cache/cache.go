@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/gopherd/core/component"
+	"github.com/gopherd/example/components/cache/cacheapi"
+)
+
+const name = "github.com/gopherd/example/components/cache"
+
+var _ cacheapi.Component = (*cacheComponent)(nil)
+
+func init() {
+	component.Register(name, func() component.Component {
+		return new(cacheComponent)
+	})
+}
+
+type cacheComponent struct {
+	component.BaseComponent[struct {
+		Master struct {
+			Host     string
+			Port     int
+			Password string
+			DB       int
+		}
+		PoolSize     int
+		MinIdleConns int
+		DialTimeout  time.Duration
+	}]
+	client *redis.Client
+}
+
+func (c *cacheComponent) Init(ctx context.Context) error {
+	opts := c.Options()
+	c.client = redis.NewClient(&redis.Options{
+		Addr:         fmt.Sprintf("%s:%d", opts.Master.Host, opts.Master.Port),
+		Password:     opts.Master.Password,
+		DB:           opts.Master.DB,
+		PoolSize:     opts.PoolSize,
+		MinIdleConns: opts.MinIdleConns,
+		DialTimeout:  opts.DialTimeout,
+	})
+	return c.client.Ping(ctx).Err()
+}
+
+func (c *cacheComponent) Shutdown(ctx context.Context) error {
+	return c.client.Close()
+}
+
+func (c *cacheComponent) Get(ctx context.Context, key string) (string, error) {
+	value, err := c.client.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return value, err
+}
+
+func (c *cacheComponent) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	return c.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (c *cacheComponent) Del(ctx context.Context, keys ...string) error {
+	return c.client.Del(ctx, keys...).Err()
+}
+
+func (c *cacheComponent) Incr(ctx context.Context, key string) (int64, error) {
+	return c.client.Incr(ctx, key).Result()
+}
+
+func (c *cacheComponent) Expire(ctx context.Context, key string, ttl time.Duration) error {
+	return c.client.Expire(ctx, key, ttl).Err()
+}
+
+func (c *cacheComponent) Pipeline() cacheapi.Pipeliner {
+	return c.client.Pipeline()
+}
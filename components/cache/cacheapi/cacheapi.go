@@ -0,0 +1,23 @@
+package cacheapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Pipeliner 暴露底层 Redis 驱动的管道接口，供需要批量提交命令的调用方使用
+type Pipeliner = redis.Pipeliner
+
+// Component 对外暴露一个带连接池的 Redis 客户端
+type Component interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value string, ttl time.Duration) error
+	Del(ctx context.Context, keys ...string) error
+	Incr(ctx context.Context, key string) (int64, error)
+	Expire(ctx context.Context, key string, ttl time.Duration) error
+
+	// Pipeline 返回一个管道，调用方可以在其上排队多条命令后一次性 Exec
+	Pipeline() Pipeliner
+}
@@ -3,8 +3,17 @@ package components
 import (
 	_ "github.com/gopherd/example/components/auth"
 	_ "github.com/gopherd/example/components/blockexit"
+	_ "github.com/gopherd/example/components/cache"
+	_ "github.com/gopherd/example/components/config"
+	_ "github.com/gopherd/example/components/cron"
+	_ "github.com/gopherd/example/components/db"
 	_ "github.com/gopherd/example/components/eventsystem"
 	_ "github.com/gopherd/example/components/httpserver"
+	_ "github.com/gopherd/example/components/jobs"
 	_ "github.com/gopherd/example/components/logger"
 	_ "github.com/gopherd/example/components/users"
+
+	// db's Driver option defaults to mysql-style DSNs (see buildDSN), so this is the
+	// driver actually exercised by sql.Open when the db component is configured
+	_ "github.com/go-sql-driver/mysql"
 )
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/gopherd/core/component"
+	"github.com/gopherd/example/components/config/configapi"
+)
+
+const name = "github.com/gopherd/example/components/config"
+
+var _ configapi.Component = (*configComponent)(nil)
+
+func init() {
+	component.Register(name, func() component.Component {
+		return new(configComponent)
+	})
+}
+
+type configComponent struct {
+	component.BaseComponent[struct {
+		// Source 是合并时使用的基础配置文件路径；留空时不加载任何配置，Get 返回 nil，
+		// Bind 返回路径不存在的错误
+		Source string
+		// Watch 为 true 时额外启用 fsnotify 监听 Source 变化并自动热加载
+		Watch bool
+	}]
+	mu        sync.RWMutex
+	tree      map[string]any
+	listeners map[string][]func()
+
+	watcher *fsnotify.Watcher
+	sighup  chan os.Signal
+	stop    chan struct{}
+}
+
+func (c *configComponent) Init(ctx context.Context) error {
+	c.listeners = make(map[string][]func())
+	if c.Options().Source == "" {
+		return nil
+	}
+	tree, err := Merge(c.Options().Source)
+	if err != nil {
+		return fmt.Errorf("config: failed to load %q: %w", c.Options().Source, err)
+	}
+	c.tree = tree
+	return nil
+}
+
+// Start 在配置了 Source 时监听 SIGHUP（始终开启）以及 Watch 配置的 fsnotify（可选），
+// 任意一种信号到来都会触发一次完整的重新加载
+func (c *configComponent) Start(ctx context.Context) error {
+	if c.Options().Source == "" {
+		return nil
+	}
+	c.stop = make(chan struct{})
+	c.sighup = make(chan os.Signal, 1)
+	signal.Notify(c.sighup, syscall.SIGHUP)
+
+	if c.Options().Watch {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return fmt.Errorf("config: failed to start file watcher: %w", err)
+		}
+		if err := watcher.Add(c.Options().Source); err != nil {
+			watcher.Close()
+			return fmt.Errorf("config: failed to watch %q: %w", c.Options().Source, err)
+		}
+		c.watcher = watcher
+	}
+
+	go c.watchLoop()
+	return nil
+}
+
+func (c *configComponent) watchLoop() {
+	var events <-chan fsnotify.Event
+	if c.watcher != nil {
+		events = c.watcher.Events
+	}
+	for {
+		select {
+		case <-c.sighup:
+			c.Logger().Info("Reloading config after SIGHUP")
+			c.reload()
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+				c.Logger().Info("Reloading config after file change", "path", event.Name)
+				c.reload()
+			}
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+func (c *configComponent) reload() {
+	tree, err := Merge(c.Options().Source)
+	if err != nil {
+		c.Logger().Error("Failed to reload config", "error", err)
+		return
+	}
+
+	c.mu.Lock()
+	old := c.tree
+	c.tree = tree
+	listeners := make(map[string][]func(), len(c.listeners))
+	for path, cbs := range c.listeners {
+		listeners[path] = cbs
+	}
+	c.mu.Unlock()
+
+	for path, cbs := range listeners {
+		oldValue, _ := getPath(old, path)
+		newValue, _ := getPath(tree, path)
+		if !reflect.DeepEqual(oldValue, newValue) {
+			for _, cb := range cbs {
+				cb()
+			}
+		}
+	}
+}
+
+func (c *configComponent) Shutdown(ctx context.Context) error {
+	if c.stop == nil {
+		return nil
+	}
+	signal.Stop(c.sighup)
+	close(c.stop)
+	if c.watcher != nil {
+		return c.watcher.Close()
+	}
+	return nil
+}
+
+func (c *configComponent) Get(path string) any {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	value, _ := getPath(c.tree, path)
+	return value
+}
+
+func (c *configComponent) Bind(path string, out any) error {
+	c.mu.RLock()
+	value, ok := getPath(c.tree, path)
+	c.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("config: path %q not found", path)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("config: failed to marshal %q: %w", path, err)
+	}
+	return json.Unmarshal(data, out)
+}
+
+func (c *configComponent) OnChange(path string, cb func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners[path] = append(c.listeners[path], cb)
+}
@@ -0,0 +1,14 @@
+package configapi
+
+// Component 暴露合并后的配置树，供其他组件按路径读取或在值变化时收到通知
+type Component interface {
+	// Get 按路径（用 "." 分隔，切片下标用数字表示）返回配置树中的任意值，
+	// 路径不存在时返回 nil
+	Get(path string) any
+
+	// Bind 把路径对应的值解码进 out，out 通常是一个指向 struct 的指针
+	Bind(path string, out any) error
+
+	// OnChange 注册一个回调，在下一次重新加载后若该路径对应的值发生变化就会被调用
+	OnChange(path string, cb func())
+}
@@ -0,0 +1,220 @@
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// envPrefix 标记哪些环境变量（包括 .env 文件里的条目）应该被当作配置覆盖来解析
+const envPrefix = "APP__"
+
+// Merge 读取 source 指向的基础配置文件（按扩展名自动识别 yaml/toml/json），
+// 依次叠加同目录下的 .env 文件与 APP__ 前缀的环境变量，
+// 展开 ${ENV:NAME} / ${ENV:NAME:default} 占位符后返回合并好的配置树
+func Merge(source string) (map[string]any, error) {
+	tree, err := load(source)
+	if err != nil {
+		return nil, err
+	}
+
+	dotenv, err := loadDotenv(filepath.Join(filepath.Dir(source), ".env"))
+	if err != nil {
+		return nil, err
+	}
+	applyOverrides(tree, filterPrefix(dotenv, envPrefix))
+	applyOverrides(tree, filterPrefix(environMap(os.Environ()), envPrefix))
+
+	return expandEnv(tree).(map[string]any), nil
+}
+
+// MergeJSON 等价于 Merge，但直接编码为 JSON，便于喂给只理解 JSON 的消费方
+func MergeJSON(source string) ([]byte, error) {
+	tree, err := Merge(source)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(tree)
+}
+
+func load(source string) (map[string]any, error) {
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return nil, fmt.Errorf("config: failed to read %q: %w", source, err)
+	}
+
+	var tree map[string]any
+	switch strings.ToLower(filepath.Ext(source)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &tree); err != nil {
+			return nil, fmt.Errorf("config: failed to parse yaml %q: %w", source, err)
+		}
+	case ".toml":
+		if err := toml.Unmarshal(data, &tree); err != nil {
+			return nil, fmt.Errorf("config: failed to parse toml %q: %w", source, err)
+		}
+	default:
+		if err := json.Unmarshal(data, &tree); err != nil {
+			return nil, fmt.Errorf("config: failed to parse json %q: %w", source, err)
+		}
+	}
+	if tree == nil {
+		tree = make(map[string]any)
+	}
+	return tree, nil
+}
+
+// loadDotenv 解析形如 KEY=VALUE 的文件，忽略空行、# 开头的注释，并去掉两侧的引号；
+// 文件不存在时返回空结果而不是错误
+func loadDotenv(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("config: failed to read dotenv %q: %w", path, err)
+	}
+	defer f.Close()
+
+	env := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		env[strings.TrimSpace(key)] = unquote(strings.TrimSpace(value))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("config: failed to scan dotenv %q: %w", path, err)
+	}
+	return env, nil
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 {
+		if (value[0] == '"' && value[len(value)-1] == '"') || (value[0] == '\'' && value[len(value)-1] == '\'') {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
+
+func environMap(environ []string) map[string]string {
+	env := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		if key, value, ok := strings.Cut(kv, "="); ok {
+			env[key] = value
+		}
+	}
+	return env
+}
+
+func filterPrefix(env map[string]string, prefix string) map[string]string {
+	filtered := make(map[string]string)
+	for key, value := range env {
+		if strings.HasPrefix(key, prefix) {
+			filtered[strings.TrimPrefix(key, prefix)] = value
+		}
+	}
+	return filtered
+}
+
+// applyOverrides 把 COMPONENT__FIELD 形式的 key（用 __ 分隔路径，__N__ 表示切片下标）写入 tree
+func applyOverrides(tree map[string]any, overrides map[string]string) {
+	for key, value := range overrides {
+		setPath(tree, strings.Split(key, "__"), coerce(value))
+	}
+}
+
+func setPath(node map[string]any, segments []string, value any) {
+	if len(segments) == 0 {
+		return
+	}
+	key := segments[0]
+	if len(segments) == 1 {
+		node[key] = value
+		return
+	}
+
+	// 下一段能解析成数字时，说明当前 key 对应的是一个切片
+	if idx, err := strconv.Atoi(segments[1]); err == nil {
+		slice, _ := node[key].([]any)
+		for len(slice) <= idx {
+			slice = append(slice, map[string]any{})
+		}
+		if len(segments) == 2 {
+			slice[idx] = value
+		} else {
+			child, _ := slice[idx].(map[string]any)
+			if child == nil {
+				child = make(map[string]any)
+			}
+			setPath(child, segments[2:], value)
+			slice[idx] = child
+		}
+		node[key] = slice
+		return
+	}
+
+	child, _ := node[key].(map[string]any)
+	if child == nil {
+		child = make(map[string]any)
+	}
+	setPath(child, segments[1:], value)
+	node[key] = child
+}
+
+// coerce 尽量把环境变量的字符串值还原成 bool/整数/浮点数，否则保留原始字符串
+func coerce(value string) any {
+	if b, err := strconv.ParseBool(value); err == nil {
+		return b
+	}
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	return value
+}
+
+var envPlaceholder = regexp.MustCompile(`\$\{ENV:([^}:]+)(?::([^}]*))?\}`)
+
+// expandEnv 递归展开字符串叶子节点里的 ${ENV:NAME} 或 ${ENV:NAME:default} 占位符
+func expandEnv(node any) any {
+	switch v := node.(type) {
+	case map[string]any:
+		for key, value := range v {
+			v[key] = expandEnv(value)
+		}
+		return v
+	case []any:
+		for i, value := range v {
+			v[i] = expandEnv(value)
+		}
+		return v
+	case string:
+		return envPlaceholder.ReplaceAllStringFunc(v, func(match string) string {
+			groups := envPlaceholder.FindStringSubmatch(match)
+			if value, ok := os.LookupEnv(groups[1]); ok {
+				return value
+			}
+			return groups[2]
+		})
+	default:
+		return v
+	}
+}
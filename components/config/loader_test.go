@@ -0,0 +1,116 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadDotenv(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".env")
+	content := "# comment\n\nAPP__LOGGER__LEVEL=debug\nAPP__NAME=\"quoted\"\nAPP__TAG='single'\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	env, err := loadDotenv(path)
+	if err != nil {
+		t.Fatalf("loadDotenv() error = %v", err)
+	}
+	want := map[string]string{
+		"APP__LOGGER__LEVEL": "debug",
+		"APP__NAME":          "quoted",
+		"APP__TAG":           "single",
+	}
+	for key, value := range want {
+		if env[key] != value {
+			t.Fatalf("loadDotenv()[%q] = %q, want %q", key, env[key], value)
+		}
+	}
+}
+
+func TestLoadDotenvMissingFileReturnsEmpty(t *testing.T) {
+	env, err := loadDotenv(filepath.Join(t.TempDir(), "does-not-exist.env"))
+	if err != nil {
+		t.Fatalf("loadDotenv() error = %v", err)
+	}
+	if len(env) != 0 {
+		t.Fatalf("loadDotenv() = %v, want empty", env)
+	}
+}
+
+func TestApplyOverridesSetsNestedAndSliceIndex(t *testing.T) {
+	tree := map[string]any{}
+	applyOverrides(tree, map[string]string{
+		"LOGGER__LEVEL": "debug",
+		"DB__HOSTS__0":  "a",
+		"DB__HOSTS__1":  "b",
+		"FEATURE__ON":   "true",
+	})
+
+	logger, _ := tree["LOGGER"].(map[string]any)
+	if logger["LEVEL"] != "debug" {
+		t.Fatalf("LOGGER.LEVEL = %v, want %q", logger["LEVEL"], "debug")
+	}
+
+	feature, _ := tree["FEATURE"].(map[string]any)
+	if feature["ON"] != true {
+		t.Fatalf("FEATURE.ON = %v (%T), want bool true", feature["ON"], feature["ON"])
+	}
+
+	db, _ := tree["DB"].(map[string]any)
+	hosts, _ := db["HOSTS"].([]any)
+	if len(hosts) != 2 || hosts[0] != "a" || hosts[1] != "b" {
+		t.Fatalf("DB.HOSTS = %v, want [a b]", hosts)
+	}
+}
+
+func TestCoerce(t *testing.T) {
+	cases := map[string]any{
+		"true":  true,
+		"false": false,
+		"42":    int64(42),
+		"3.14":  3.14,
+		"hello": "hello",
+	}
+	for input, want := range cases {
+		if got := coerce(input); got != want {
+			t.Fatalf("coerce(%q) = %v (%T), want %v (%T)", input, got, got, want, want)
+		}
+	}
+}
+
+func TestExpandEnvUsesValueOrDefault(t *testing.T) {
+	t.Setenv("CONFIG_TEST_VAR", "real-value")
+
+	tree := map[string]any{
+		"set":     "${ENV:CONFIG_TEST_VAR}",
+		"unset":   "${ENV:CONFIG_TEST_VAR_UNSET:fallback}",
+		"literal": "no placeholder here",
+	}
+	got := expandEnv(tree).(map[string]any)
+	if got["set"] != "real-value" {
+		t.Fatalf("expandEnv()[\"set\"] = %q, want %q", got["set"], "real-value")
+	}
+	if got["unset"] != "fallback" {
+		t.Fatalf("expandEnv()[\"unset\"] = %q, want %q", got["unset"], "fallback")
+	}
+	if got["literal"] != "no placeholder here" {
+		t.Fatalf("expandEnv()[\"literal\"] = %q, want unchanged", got["literal"])
+	}
+}
+
+func TestFilterPrefix(t *testing.T) {
+	env := map[string]string{
+		"APP__LOGGER__LEVEL": "debug",
+		"PATH":               "/usr/bin",
+	}
+	filtered := filterPrefix(env, envPrefix)
+	if _, ok := filtered["PATH"]; ok {
+		t.Fatal("expected PATH to be filtered out")
+	}
+	if filtered["LOGGER__LEVEL"] != "debug" {
+		t.Fatalf("filterPrefix()[\"LOGGER__LEVEL\"] = %q, want %q", filtered["LOGGER__LEVEL"], "debug")
+	}
+}
@@ -0,0 +1,42 @@
+package config
+
+import "strconv"
+
+// getPath 按 "." 分隔的路径在通用配置树（map[string]any / []any）中查找一个值
+func getPath(tree any, path string) (any, bool) {
+	if path == "" {
+		return tree, true
+	}
+	cur := tree
+	for _, seg := range splitPath(path) {
+		switch node := cur.(type) {
+		case map[string]any:
+			v, ok := node[seg]
+			if !ok {
+				return nil, false
+			}
+			cur = v
+		case []any:
+			idx, err := strconv.Atoi(seg)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, false
+			}
+			cur = node[idx]
+		default:
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func splitPath(path string) []string {
+	var segments []string
+	start := 0
+	for i := 0; i < len(path); i++ {
+		if path[i] == '.' {
+			segments = append(segments, path[start:i])
+			start = i + 1
+		}
+	}
+	return append(segments, path[start:])
+}
@@ -0,0 +1,72 @@
+package config
+
+import "testing"
+
+func TestGetPathNestedMapAndSlice(t *testing.T) {
+	tree := map[string]any{
+		"db": map[string]any{
+			"hosts": []any{"a", "b"},
+		},
+	}
+
+	v, ok := getPath(tree, "db.hosts.1")
+	if !ok || v != "b" {
+		t.Fatalf("getPath() = (%v, %v), want (\"b\", true)", v, ok)
+	}
+
+	v, ok = getPath(tree, "db.hosts")
+	if !ok {
+		t.Fatalf("getPath() ok = false, want true")
+	}
+	if _, isSlice := v.([]any); !isSlice {
+		t.Fatalf("getPath() = %T, want []any", v)
+	}
+}
+
+func TestGetPathEmptyReturnsTree(t *testing.T) {
+	tree := map[string]any{"a": 1}
+	v, ok := getPath(tree, "")
+	if !ok {
+		t.Fatal("getPath(\"\") ok = false, want true")
+	}
+	m, isMap := v.(map[string]any)
+	if !isMap || m["a"] != 1 {
+		t.Fatalf("getPath(\"\") = %v, want tree itself", v)
+	}
+}
+
+func TestGetPathMissingKeyOrIndex(t *testing.T) {
+	tree := map[string]any{
+		"db": map[string]any{
+			"hosts": []any{"a"},
+		},
+	}
+	if _, ok := getPath(tree, "db.missing"); ok {
+		t.Fatal("expected missing key to return ok = false")
+	}
+	if _, ok := getPath(tree, "db.hosts.9"); ok {
+		t.Fatal("expected out-of-range index to return ok = false")
+	}
+	if _, ok := getPath(tree, "db.hosts.notanumber"); ok {
+		t.Fatal("expected non-numeric slice index to return ok = false")
+	}
+}
+
+func TestSplitPath(t *testing.T) {
+	cases := map[string][]string{
+		"a.b.c": {"a", "b", "c"},
+		"a":     {"a"},
+		"":      {""},
+	}
+	for path, want := range cases {
+		got := splitPath(path)
+		if len(got) != len(want) {
+			t.Fatalf("splitPath(%q) = %v, want %v", path, got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("splitPath(%q) = %v, want %v", path, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+)
+
+// Preprocess inspects the command line for a local config file argument and,
+// if present, replaces it with the merged result of that file, a co-located
+// .env file, and APP__ prefixed environment variables. The merged JSON is
+// piped through stdin and the argument rewritten to "-" so that
+// service.Run keeps reading a single config source exactly as before.
+// Remote sources (http/https) and "-" (already stdin) are left untouched.
+func Preprocess() error {
+	args := flag.Args()
+	if len(args) == 0 {
+		return nil
+	}
+	source := args[0]
+	if source == "" || source == "-" || isRemote(source) {
+		return nil
+	}
+
+	merged, err := MergeJSON(source)
+	if err != nil {
+		return err
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return fmt.Errorf("config: failed to create pipe: %w", err)
+	}
+	go func() {
+		defer w.Close()
+		w.Write(merged)
+	}()
+	os.Stdin = r
+
+	for i, arg := range os.Args {
+		if i > 0 && arg == source {
+			os.Args[i] = "-"
+			break
+		}
+	}
+	return nil
+}
+
+func isRemote(source string) bool {
+	u, err := url.Parse(source)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
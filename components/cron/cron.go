@@ -0,0 +1,146 @@
+package cron
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gopherd/core/component"
+	"github.com/gopherd/example/components/cron/cronapi"
+	"github.com/gopherd/example/runmode"
+)
+
+const name = "github.com/gopherd/example/components/cron"
+
+// errCronNotRunning 表示当前进程的运行模式未启用 cron 组件，拒绝接受新的调度请求
+var errCronNotRunning = errors.New("cron: component is not running in current run mode")
+
+var _ cronapi.Component = (*cronComponent)(nil)
+
+func init() {
+	component.Register(name, func() component.Component {
+		return new(cronComponent)
+	})
+}
+
+// job 是一个已注册的定时任务及其运行状态
+type job struct {
+	schedule Schedule
+	fn       func(context.Context) error
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+type cronComponent struct {
+	component.BaseComponent[struct {
+		// GracefulTimeout 是 Shutdown 时等待正在执行的任务完成的最长时间
+		GracefulTimeout time.Duration
+	}]
+	mu     sync.Mutex
+	jobs   map[string]*job
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (c *cronComponent) Init(ctx context.Context) error {
+	c.jobs = make(map[string]*job)
+	return nil
+}
+
+func (c *cronComponent) Start(ctx context.Context) error {
+	if !runmode.Enabled(runmode.Cron) {
+		c.Logger().Info("Cron component disabled in current run mode")
+		return nil
+	}
+	c.Logger().Info("Starting Cron component")
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	return nil
+}
+
+func (c *cronComponent) Shutdown(ctx context.Context) error {
+	if c.cancel == nil {
+		return nil
+	}
+	c.Logger().Info("Shutting down Cron component")
+
+	c.mu.Lock()
+	jobs := make([]*job, 0, len(c.jobs))
+	for _, j := range c.jobs {
+		jobs = append(jobs, j)
+	}
+	c.mu.Unlock()
+	c.cancel()
+
+	timeout := c.Options().GracefulTimeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	deadline := time.After(timeout)
+	for _, j := range jobs {
+		select {
+		case <-j.done:
+		case <-deadline:
+			c.Logger().Warn("cron jobs did not finish before graceful timeout")
+			return nil
+		}
+	}
+	return nil
+}
+
+func (c *cronComponent) Schedule(spec string, fn func(context.Context) error) (string, error) {
+	if c.ctx == nil {
+		return "", errCronNotRunning
+	}
+	schedule, err := parseSchedule(spec)
+	if err != nil {
+		return "", err
+	}
+	id := generateID()
+	jobCtx, cancel := context.WithCancel(c.ctx)
+	j := &job{schedule: schedule, fn: fn, cancel: cancel, done: make(chan struct{})}
+
+	c.mu.Lock()
+	c.jobs[id] = j
+	c.mu.Unlock()
+
+	go c.run(jobCtx, id, j)
+	return id, nil
+}
+
+func (c *cronComponent) Remove(id string) {
+	c.mu.Lock()
+	j, ok := c.jobs[id]
+	delete(c.jobs, id)
+	c.mu.Unlock()
+	if ok {
+		j.cancel()
+	}
+}
+
+func (c *cronComponent) run(ctx context.Context, id string, j *job) {
+	defer close(j.done)
+	for {
+		next := j.schedule.Next(time.Now())
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			if err := j.fn(ctx); err != nil {
+				c.Logger().Error("cron job failed", "id", id, "error", err)
+			}
+		}
+	}
+}
+
+func generateID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,12 @@
+package cronapi
+
+import "context"
+
+type Component interface {
+	// Schedule 注册一个定时任务，spec 支持标准 5 字段 cron 表达式（分 时 日 月 星期）
+	// 以及 @every <duration>、@daily、@hourly 等简写，返回的 id 可用于 Remove
+	Schedule(spec string, job func(context.Context) error) (id string, err error)
+
+	// Remove 取消一个通过 Schedule 注册的定时任务
+	Remove(id string)
+}
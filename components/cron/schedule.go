@@ -0,0 +1,163 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule 计算某个任务下一次应该触发的时间
+type Schedule interface {
+	Next(t time.Time) time.Time
+}
+
+// everySchedule 对应 `@every <duration>`，Next 返回 t 加上固定间隔；cronComponent 在上一次
+// 任务执行结束后以当前时间调用 Next，因此间隔从上一次执行结束时算起
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+// fieldSchedule 对应标准 5 字段 cron 表达式：分 时 日 月 星期
+type fieldSchedule struct {
+	minutes map[int]bool
+	hours   map[int]bool
+	doms    map[int]bool
+	months  map[int]bool
+	dows    map[int]bool
+
+	// domRestricted/dowRestricted 记录日期/星期字段是否为 "*"。按 Vixie cron 的惯例，
+	// 两者都被限定时取「或」，否则取「与」（未限定的一侧恒为真，与运算不影响结果）
+	domRestricted bool
+	dowRestricted bool
+}
+
+// parseSchedule 解析标准 5 字段 cron 表达式，或 @every/@hourly/@daily/@weekly/@monthly/@yearly 简写
+func parseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	switch spec {
+	case "@hourly":
+		spec = "0 * * * *"
+	case "@daily", "@midnight":
+		spec = "0 0 * * *"
+	case "@weekly":
+		spec = "0 0 * * 0"
+	case "@monthly":
+		spec = "0 0 1 * *"
+	case "@yearly", "@annually":
+		spec = "0 0 1 1 *"
+	}
+	if strings.HasPrefix(spec, "@every ") {
+		d, err := time.ParseDuration(strings.TrimPrefix(spec, "@every "))
+		if err != nil {
+			return nil, fmt.Errorf("cron: invalid @every duration: %w", err)
+		}
+		if d <= 0 {
+			return nil, errors.New("cron: @every duration must be positive")
+		}
+		return everySchedule{interval: d}, nil
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, errors.New("cron: expect 5 fields (minute hour dom month dow), got " + spec)
+	}
+	minutes, err := parseField(fields[0], 0, 59)
+	if err != nil {
+		return nil, err
+	}
+	hours, err := parseField(fields[1], 0, 23)
+	if err != nil {
+		return nil, err
+	}
+	doms, err := parseField(fields[2], 1, 31)
+	if err != nil {
+		return nil, err
+	}
+	months, err := parseField(fields[3], 1, 12)
+	if err != nil {
+		return nil, err
+	}
+	dows, err := parseField(fields[4], 0, 6)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldSchedule{
+		minutes:       minutes,
+		hours:         hours,
+		doms:          doms,
+		months:        months,
+		dows:          dows,
+		domRestricted: fields[2] != "*",
+		dowRestricted: fields[4] != "*",
+	}, nil
+}
+
+// parseField 解析单个字段，支持 `*`、`a`、`a-b`、`a,b,c` 和 `.../n` 步长
+func parseField(field string, min, max int) (map[int]bool, error) {
+	values := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		rangePart := part
+		step := 1
+		if idx := strings.IndexByte(part, '/'); idx >= 0 {
+			rangePart = part[:idx]
+			s, err := strconv.Atoi(part[idx+1:])
+			if err != nil || s <= 0 {
+				return nil, errors.New("cron: invalid step in field " + field)
+			}
+			step = s
+		}
+		lo, hi := min, max
+		if rangePart != "*" {
+			if idx := strings.IndexByte(rangePart, '-'); idx >= 0 {
+				l, errL := strconv.Atoi(rangePart[:idx])
+				h, errH := strconv.Atoi(rangePart[idx+1:])
+				if errL != nil || errH != nil {
+					return nil, errors.New("cron: invalid range in field " + field)
+				}
+				lo, hi = l, h
+			} else {
+				v, err := strconv.Atoi(rangePart)
+				if err != nil {
+					return nil, errors.New("cron: invalid value in field " + field)
+				}
+				lo, hi = v, v
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return nil, errors.New("cron: value out of range in field " + field)
+		}
+		for v := lo; v <= hi; v += step {
+			values[v] = true
+		}
+	}
+	return values, nil
+}
+
+func (s *fieldSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	// 逐分钟暴力查找下一个匹配的时间点，限定在 4 年内，避免无法满足的表达式导致死循环
+	limit := t.AddDate(4, 0, 0)
+	for t.Before(limit) {
+		if s.months[int(t.Month())] && s.dateMatches(t) && s.hours[t.Hour()] && s.minutes[t.Minute()] {
+			return t
+		}
+		t = t.Add(time.Minute)
+	}
+	return limit
+}
+
+// dateMatches 判断 day-of-month 和 day-of-week 是否匹配。两者都被限定时取「或」，
+// 否则取「与」——这是标准 5 字段（Vixie）cron 的惯例，例如 "0 0 13 * 5" 表示每月 13 号
+// 或每周五，而不是 13 号恰好是周五那一天
+func (s *fieldSchedule) dateMatches(t time.Time) bool {
+	if s.domRestricted && s.dowRestricted {
+		return s.doms[t.Day()] || s.dows[int(t.Weekday())]
+	}
+	return s.doms[t.Day()] && s.dows[int(t.Weekday())]
+}
@@ -0,0 +1,140 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseScheduleShorthands(t *testing.T) {
+	cases := map[string]string{
+		"@hourly":   "0 * * * *",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@weekly":   "0 0 * * 0",
+		"@monthly":  "0 0 1 * *",
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+	}
+	for shorthand, equivalent := range cases {
+		got, err := parseSchedule(shorthand)
+		if err != nil {
+			t.Fatalf("parseSchedule(%q) error = %v", shorthand, err)
+		}
+		want, err := parseSchedule(equivalent)
+		if err != nil {
+			t.Fatalf("parseSchedule(%q) error = %v", equivalent, err)
+		}
+		from := time.Date(2026, 7, 25, 10, 30, 0, 0, time.UTC)
+		if got.Next(from) != want.Next(from) {
+			t.Fatalf("%q.Next() = %v, want %v (same as %q)", shorthand, got.Next(from), want.Next(from), equivalent)
+		}
+	}
+}
+
+func TestParseScheduleEvery(t *testing.T) {
+	s, err := parseSchedule("@every 90s")
+	if err != nil {
+		t.Fatalf("parseSchedule() error = %v", err)
+	}
+	from := time.Date(2026, 7, 25, 10, 0, 0, 0, time.UTC)
+	want := from.Add(90 * time.Second)
+	if got := s.Next(from); !got.Equal(want) {
+		t.Fatalf("Next() = %v, want %v", got, want)
+	}
+}
+
+func TestParseScheduleEveryRejectsNonPositive(t *testing.T) {
+	if _, err := parseSchedule("@every -1s"); err == nil {
+		t.Fatal("expected error for non-positive @every duration")
+	}
+	if _, err := parseSchedule("@every bogus"); err == nil {
+		t.Fatal("expected error for invalid @every duration")
+	}
+}
+
+func TestParseFieldStepAndRange(t *testing.T) {
+	cases := []struct {
+		field string
+		min   int
+		max   int
+		want  []int
+	}{
+		{"*", 0, 4, []int{0, 1, 2, 3, 4}},
+		{"*/15", 0, 59, []int{0, 15, 30, 45}},
+		{"1-5", 0, 10, []int{1, 2, 3, 4, 5}},
+		{"1-10/3", 0, 10, []int{1, 4, 7, 10}},
+		{"1,3,5", 0, 10, []int{1, 3, 5}},
+	}
+	for _, tc := range cases {
+		got, err := parseField(tc.field, tc.min, tc.max)
+		if err != nil {
+			t.Fatalf("parseField(%q) error = %v", tc.field, err)
+		}
+		if len(got) != len(tc.want) {
+			t.Fatalf("parseField(%q) = %v, want values %v", tc.field, got, tc.want)
+		}
+		for _, v := range tc.want {
+			if !got[v] {
+				t.Fatalf("parseField(%q) missing value %d, got %v", tc.field, v, got)
+			}
+		}
+	}
+}
+
+func TestParseFieldRejectsOutOfRange(t *testing.T) {
+	if _, err := parseField("99", 0, 59); err == nil {
+		t.Fatal("expected error for out-of-range value")
+	}
+	if _, err := parseField("5-1", 0, 59); err == nil {
+		t.Fatal("expected error for inverted range")
+	}
+	if _, err := parseField("*/0", 0, 59); err == nil {
+		t.Fatal("expected error for non-positive step")
+	}
+}
+
+func TestParseScheduleRejectsWrongFieldCount(t *testing.T) {
+	if _, err := parseSchedule("* * *"); err == nil {
+		t.Fatal("expected error for wrong number of fields")
+	}
+}
+
+func TestFieldScheduleNextFindsNextMatchingMinute(t *testing.T) {
+	// Every day at 09:30
+	s, err := parseSchedule("30 9 * * *")
+	if err != nil {
+		t.Fatalf("parseSchedule() error = %v", err)
+	}
+	from := time.Date(2026, 7, 25, 10, 0, 0, 0, time.UTC) // after 09:30 today
+	got := s.Next(from)
+	want := time.Date(2026, 7, 26, 9, 30, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("Next() = %v, want %v", got, want)
+	}
+}
+
+func TestFieldScheduleOrsRestrictedDomAndDow(t *testing.T) {
+	// "0 0 13 * 5" means the 13th OR any Friday, not the 13th-if-it's-a-Friday
+	s, err := parseSchedule("0 0 13 * 5")
+	if err != nil {
+		t.Fatalf("parseSchedule() error = %v", err)
+	}
+	from := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	want := time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC) // the next Friday, well before Nov 13
+	if got := s.Next(from); !got.Equal(want) {
+		t.Fatalf("Next() = %v, want %v", got, want)
+	}
+}
+
+func TestFieldScheduleAndsWhenOnlyOneOfDomDowRestricted(t *testing.T) {
+	// dow is "*", so only dom is effective
+	s, err := parseSchedule("0 0 13 * *")
+	if err != nil {
+		t.Fatalf("parseSchedule() error = %v", err)
+	}
+	from := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	want := time.Date(2026, 7, 13, 0, 0, 0, 0, time.UTC)
+	if got := s.Next(from); !got.Equal(want) {
+		t.Fatalf("Next() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/gopherd/core/component"
+	"github.com/gopherd/example/components/db/dbapi"
+)
+
+const name = "github.com/gopherd/example/components/db"
+
+var _ dbapi.Component = (*dbComponent)(nil)
+
+func init() {
+	component.Register(name, func() component.Component {
+		return new(dbComponent)
+	})
+}
+
+// Endpoint 描述一个数据库连接目标
+type Endpoint struct {
+	Host     string
+	Port     int
+	User     string
+	Password string
+	DBName   string
+}
+
+type dbComponent struct {
+	component.BaseComponent[struct {
+		Driver string
+		Option struct {
+			MaxConns       int
+			MaxIdle        int
+			IdleTimeout    time.Duration
+			ConnectTimeout time.Duration
+			Charset        string
+		}
+		Master Endpoint
+		Slaves []Endpoint
+	}]
+	master   *sql.DB
+	replicas []*sql.DB
+	cursor   uint64
+}
+
+func (d *dbComponent) Init(ctx context.Context) error {
+	opts := d.Options()
+
+	master, err := d.open(opts.Master)
+	if err != nil {
+		return fmt.Errorf("db: failed to open master: %w", err)
+	}
+	d.master = master
+
+	d.replicas = make([]*sql.DB, 0, len(opts.Slaves))
+	for i, slave := range opts.Slaves {
+		replica, err := d.open(slave)
+		if err != nil {
+			return fmt.Errorf("db: failed to open replica %d (%s:%d): %w", i, slave.Host, slave.Port, err)
+		}
+		d.replicas = append(d.replicas, replica)
+	}
+	return nil
+}
+
+func (d *dbComponent) open(endpoint Endpoint) (*sql.DB, error) {
+	opts := d.Options()
+	db, err := sql.Open(opts.Driver, buildDSN(opts.Driver, endpoint, opts.Option.Charset))
+	if err != nil {
+		return nil, err
+	}
+	if opts.Option.MaxConns > 0 {
+		db.SetMaxOpenConns(opts.Option.MaxConns)
+	}
+	if opts.Option.MaxIdle > 0 {
+		db.SetMaxIdleConns(opts.Option.MaxIdle)
+	}
+	if opts.Option.IdleTimeout > 0 {
+		db.SetConnMaxIdleTime(opts.Option.IdleTimeout)
+	}
+
+	ctx := context.Background()
+	if opts.Option.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Option.ConnectTimeout)
+		defer cancel()
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// buildDSN 根据 driver 拼出对应方言的连接串，目前覆盖 mysql 与 postgres 两种常见驱动
+func buildDSN(driver string, endpoint Endpoint, charset string) string {
+	switch driver {
+	case "postgres", "pgx":
+		return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+			endpoint.Host, endpoint.Port, endpoint.User, endpoint.Password, endpoint.DBName)
+	default: // mysql 及兼容驱动
+		if charset == "" {
+			charset = "utf8mb4"
+		}
+		return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=%s&parseTime=true",
+			endpoint.User, endpoint.Password, endpoint.Host, endpoint.Port, endpoint.DBName, charset)
+	}
+}
+
+func (d *dbComponent) Shutdown(ctx context.Context) error {
+	var errs []error
+	if d.master != nil {
+		if err := d.master.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, replica := range d.replicas {
+		if err := replica.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (d *dbComponent) Master() *sql.DB {
+	return d.master
+}
+
+func (d *dbComponent) Replica() *sql.DB {
+	if len(d.replicas) == 0 {
+		return d.master
+	}
+	i := atomic.AddUint64(&d.cursor, 1)
+	return d.replicas[i%uint64(len(d.replicas))]
+}
+
+func (d *dbComponent) WithTx(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := d.master.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,18 @@
+package dbapi
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Component 对外暴露一主多从的数据库连接池，Replica 在配置的从库间做轮询
+type Component interface {
+	// Master 返回主库连接池，写操作与强一致读都应该走这里
+	Master() *sql.DB
+
+	// Replica 返回一个从库连接池，在多个从库间轮询；没有配置从库时回退到主库
+	Replica() *sql.DB
+
+	// WithTx 在主库上开启一个事务，fn 返回 error 时自动回滚，否则提交
+	WithTx(ctx context.Context, fn func(*sql.Tx) error) error
+}
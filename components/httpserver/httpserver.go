@@ -3,18 +3,41 @@ package httpserver
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/gopherd/core/component"
 	"github.com/gopherd/example/components/httpserver/httpserverapi"
+	"github.com/gopherd/example/components/httpserver/middleware"
+	"github.com/gopherd/example/runmode"
 )
 
 const name = "github.com/gopherd/example/components/httpserver"
 
+// tlsOptions 配置 HTTPS 证书，CertFile/KeyFile 均为空时服务以 HTTP 方式启动
+type tlsOptions struct {
+	CertFile string
+	KeyFile  string
+}
+
 type httpserverComponent struct {
 	component.BaseComponent[struct {
-		Addr string
+		Addr           string
+		ReadTimeout    time.Duration
+		WriteTimeout   time.Duration
+		IdleTimeout    time.Duration
+		MaxHeaderBytes int
+		TLS            tlsOptions
+
+		// Middleware 配置开箱即用的内置中间件，按 Recovery、RequestID、AccessLog、CORS 的顺序装配
+		Middleware struct {
+			Recovery  bool
+			RequestID bool
+			AccessLog bool
+			CORS      *middleware.CORSOptions
+		}
 	}]
 	server *http.Server
+	router *router
 }
 
 // 断言 httpserverComponent 实现了接口 httpserverapi.Component
@@ -31,14 +54,54 @@ func (h *httpserverComponent) Init(ctx context.Context) error {
 	if addr == "" {
 		addr = ":http"
 	}
-	h.server = &http.Server{Addr: addr}
+	mux := newServeMux()
+	h.router = &router{mux: mux}
+	// 每个 httpserverComponent 拥有自己的 mux，不再依赖 http.DefaultServeMux，
+	// 这样多个 httpserver 组件可以同时监听不同地址
+
+	// 内置中间件包在 mux 外层而不是注册到每条路由上，这样 CORS 预检、404/405 等不落在
+	// 任何已注册 (method, path) 组合上的请求也能被 Recovery/RequestID/AccessLog/CORS 处理；
+	// 这也使依赖本组件注册路由的其他组件无需任何改动即可受益
+	var globals []func(http.Handler) http.Handler
+	mwOpts := h.Options().Middleware
+	if mwOpts.Recovery {
+		globals = append(globals, middleware.Recovery(h.Logger()))
+	}
+	if mwOpts.RequestID {
+		globals = append(globals, middleware.RequestID())
+	}
+	if mwOpts.AccessLog {
+		globals = append(globals, middleware.AccessLog(h.Logger()))
+	}
+	if mwOpts.CORS != nil {
+		globals = append(globals, middleware.CORS(*mwOpts.CORS))
+	}
+	h.server = &http.Server{
+		Addr:           addr,
+		Handler:        chain(mux, globals),
+		ReadTimeout:    h.Options().ReadTimeout,
+		WriteTimeout:   h.Options().WriteTimeout,
+		IdleTimeout:    h.Options().IdleTimeout,
+		MaxHeaderBytes: h.Options().MaxHeaderBytes,
+	}
 	return nil
 }
 
 func (h *httpserverComponent) Start(ctx context.Context) error {
+	if !runmode.Enabled(runmode.API) {
+		h.Logger().Info("HTTP server disabled in current run mode")
+		return nil
+	}
 	h.Logger().Info("Starting HTTP server", "addr", h.server.Addr)
+	tls := h.Options().TLS
 	go func() {
-		if err := h.server.ListenAndServe(); err != http.ErrServerClosed {
+		var err error
+		if tls.CertFile != "" || tls.KeyFile != "" {
+			err = h.server.ListenAndServeTLS(tls.CertFile, tls.KeyFile)
+		} else {
+			err = h.server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			h.Logger().Error("HTTP server error", "error", err)
 		}
 	}()
@@ -51,5 +114,45 @@ func (h *httpserverComponent) Shutdown(ctx context.Context) error {
 }
 
 func (h *httpserverComponent) HandleFunc(pattern string, handler http.HandlerFunc) {
-	http.HandleFunc(pattern, handler)
+	h.GET(pattern, handler)
+}
+
+func (h *httpserverComponent) GET(pattern string, handler http.HandlerFunc) {
+	h.router.GET(pattern, handler)
+}
+
+func (h *httpserverComponent) POST(pattern string, handler http.HandlerFunc) {
+	h.router.POST(pattern, handler)
+}
+
+func (h *httpserverComponent) PUT(pattern string, handler http.HandlerFunc) {
+	h.router.PUT(pattern, handler)
+}
+
+func (h *httpserverComponent) PATCH(pattern string, handler http.HandlerFunc) {
+	h.router.PATCH(pattern, handler)
+}
+
+func (h *httpserverComponent) DELETE(pattern string, handler http.HandlerFunc) {
+	h.router.DELETE(pattern, handler)
+}
+
+func (h *httpserverComponent) OPTIONS(pattern string, handler http.HandlerFunc) {
+	h.router.OPTIONS(pattern, handler)
+}
+
+func (h *httpserverComponent) HEAD(pattern string, handler http.HandlerFunc) {
+	h.router.HEAD(pattern, handler)
+}
+
+func (h *httpserverComponent) Handle(method, pattern string, handler http.HandlerFunc) {
+	h.router.Handle(method, pattern, handler)
+}
+
+func (h *httpserverComponent) Group(prefix string) httpserverapi.Router {
+	return h.router.Group(prefix)
+}
+
+func (h *httpserverComponent) Use(mw ...func(http.Handler) http.Handler) {
+	h.router.Use(mw...)
 }
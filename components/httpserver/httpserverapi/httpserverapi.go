@@ -1,7 +1,56 @@
 package httpserverapi
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
+
+// Router 描述一组可注册路由的方法集合，Component 本身以及 Group 返回的子路由都实现该接口。
+type Router interface {
+	GET(pattern string, handler http.HandlerFunc)
+	POST(pattern string, handler http.HandlerFunc)
+	PUT(pattern string, handler http.HandlerFunc)
+	PATCH(pattern string, handler http.HandlerFunc)
+	DELETE(pattern string, handler http.HandlerFunc)
+	OPTIONS(pattern string, handler http.HandlerFunc)
+	HEAD(pattern string, handler http.HandlerFunc)
+
+	// Handle 以指定的 HTTP 方法注册 handler，GET/POST 等方法都是它的简写
+	Handle(method, pattern string, handler http.HandlerFunc)
+
+	// Group 返回一个带有公共路径前缀的子路由，继承当前路由已注册的中间件
+	Group(prefix string) Router
+
+	// Use 追加中间件，按注册顺序依次执行；只影响调用之后在本路由上注册的 handler，
+	// 以及调用之后才通过 Group 创建的子路由，已经创建的子路由不受影响
+	Use(mw ...func(http.Handler) http.Handler)
+}
 
 type Component interface {
+	Router
+
+	// HandleFunc 等价于 Handle(http.MethodGet, pattern, handler)，保留用于兼容旧用法
 	HandleFunc(pattern string, handler http.HandlerFunc)
 }
+
+// paramsKey 是存放路径参数的 context key 类型，避免与其他包的 key 冲突
+type paramsKey struct{}
+
+// Params 保存了通过 :name 或 *name 匹配到的路径参数
+type Params map[string]string
+
+// Get 返回参数值，参数不存在时返回空字符串
+func (p Params) Get(name string) string {
+	return p[name]
+}
+
+// ContextWithParams 返回一个携带 params 的新 context，供路由实现在匹配成功后调用
+func ContextWithParams(ctx context.Context, params Params) context.Context {
+	return context.WithValue(ctx, paramsKey{}, params)
+}
+
+// ParamsFromContext 从 context 中取出路径参数，handler 内通过它读取 :name 捕获的值
+func ParamsFromContext(ctx context.Context) Params {
+	params, _ := ctx.Value(paramsKey{}).(Params)
+	return params
+}
@@ -0,0 +1,242 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gopherd/example/components/httpserver/httpserverapi"
+)
+
+// routeNode 是一棵按路径分段组织的前缀树节点，支持静态分段、:name 参数分段和 *name 通配分段。
+type routeNode struct {
+	static       map[string]*routeNode
+	param        *routeNode
+	paramName    string
+	wildcard     *routeNode
+	wildcardName string
+	handlers     map[string]http.HandlerFunc
+}
+
+func newRouteNode() *routeNode {
+	return &routeNode{
+		static:   make(map[string]*routeNode),
+		handlers: make(map[string]http.HandlerFunc),
+	}
+}
+
+func (n *routeNode) add(method string, segments []string, handler http.HandlerFunc) {
+	cur := n
+	for i, seg := range segments {
+		switch {
+		case strings.HasPrefix(seg, ":"):
+			name := seg[1:]
+			if cur.param == nil {
+				cur.param = newRouteNode()
+				cur.paramName = name
+			} else if cur.paramName != name {
+				panic(fmt.Sprintf("httpserver: route conflict: %q already registered as :%s, cannot also register :%s", pathSoFar(segments[:i]), cur.paramName, name))
+			}
+			cur = cur.param
+		case strings.HasPrefix(seg, "*"):
+			name := seg[1:]
+			if cur.wildcard == nil {
+				cur.wildcard = newRouteNode()
+				cur.wildcardName = name
+			} else if cur.wildcardName != name {
+				panic(fmt.Sprintf("httpserver: route conflict: %q already registered as *%s, cannot also register *%s", pathSoFar(segments[:i]), cur.wildcardName, name))
+			}
+			// 通配分段捕获剩余的整段路径，之后不再继续匹配子分段
+			cur.wildcard.handlers[method] = handler
+			return
+		default:
+			child, ok := cur.static[seg]
+			if !ok {
+				child = newRouteNode()
+				cur.static[seg] = child
+			}
+			cur = child
+		}
+		if i == len(segments)-1 {
+			cur.handlers[method] = handler
+		}
+	}
+	if len(segments) == 0 {
+		cur.handlers[method] = handler
+	}
+}
+
+// pathSoFar 把已经消费掉的分段重新拼成一个路径片段，仅用于构造冲突提示信息
+func pathSoFar(segments []string) string {
+	return "/" + strings.Join(segments, "/")
+}
+
+// match 查找 segments 对应的节点。node 为 nil 表示路径完全不存在；node 非 nil 但
+// node.handlers[method] 为空表示路径存在但未注册该 method：节点上注册了其他 method 时
+// 调用方返回 405，节点上没有任何 handler（只是更长路由的中间分段）时仍返回 404。
+func (n *routeNode) match(method string, segments []string) (node *routeNode, params httpserverapi.Params) {
+	cur := n
+	for i, seg := range segments {
+		if child, ok := cur.static[seg]; ok {
+			cur = child
+			continue
+		}
+		if cur.param != nil {
+			if params == nil {
+				params = make(httpserverapi.Params)
+			}
+			params[cur.paramName] = seg
+			cur = cur.param
+			continue
+		}
+		if cur.wildcard != nil {
+			if params == nil {
+				params = make(httpserverapi.Params)
+			}
+			params[cur.wildcardName] = strings.Join(segments[i:], "/")
+			return cur.wildcard, params
+		}
+		return nil, nil
+	}
+	return cur, params
+}
+
+// allowedMethods 返回该节点上已注册方法的逗号分隔列表，用作 405 响应的 Allow 头
+func (n *routeNode) allowedMethods() string {
+	methods := make([]string, 0, len(n.handlers))
+	for method := range n.handlers {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return strings.Join(methods, ", ")
+}
+
+// splitPath 把 URL 路径切分成非空分段，"/" 对应空分段切片
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+// serveMux 是 httpserverComponent 私有的请求路由器，替代全局 http.DefaultServeMux，
+// 使得多个 httpserver 组件可以各自独立监听不同地址而互不干扰。其他组件会在各自的
+// Start 里注册路由，而 ServeHTTP 可能在监听器启动后立刻开始处理请求，二者并发访问
+// routeNode，因此需要 mu 保护，不能像之前单节点那样假设注册先于服务完成。
+type serveMux struct {
+	mu   sync.RWMutex
+	root *routeNode
+}
+
+func newServeMux() *serveMux {
+	return &serveMux{root: newRouteNode()}
+}
+
+func (m *serveMux) handle(method, pattern string, handler http.HandlerFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.root.add(method, splitPath(pattern), handler)
+}
+
+func (m *serveMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.RLock()
+	node, params := m.root.match(r.Method, splitPath(r.URL.Path))
+	var handler http.HandlerFunc
+	var ok bool
+	var allow string
+	if node != nil {
+		handler, ok = node.handlers[r.Method]
+		if !ok {
+			allow = node.allowedMethods()
+		}
+	}
+	m.mu.RUnlock()
+	if node == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if !ok {
+		if allow == "" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Allow", allow)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if params != nil {
+		r = r.WithContext(httpserverapi.ContextWithParams(r.Context(), params))
+	}
+	handler(w, r)
+}
+
+// router 实现 httpserverapi.Router，持有一个公共前缀和中间件链，并委托给共享的 serveMux 完成实际注册。
+type router struct {
+	mux         *serveMux
+	prefix      string
+	middlewares []func(http.Handler) http.Handler
+}
+
+// chain 按注册顺序把 mws 依次套在 h 外层，使 mws[0] 最先执行
+func chain(h http.Handler, mws []func(http.Handler) http.Handler) http.Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
+
+func joinPrefix(prefix, pattern string) string {
+	if pattern == "" || pattern == "/" {
+		return prefix
+	}
+	if !strings.HasPrefix(pattern, "/") {
+		pattern = "/" + pattern
+	}
+	return prefix + pattern
+}
+
+func (g *router) Handle(method, pattern string, handler http.HandlerFunc) {
+	wrapped := chain(handler, g.middlewares)
+	g.mux.handle(method, joinPrefix(g.prefix, pattern), wrapped.ServeHTTP)
+}
+
+func (g *router) GET(pattern string, handler http.HandlerFunc) {
+	g.Handle(http.MethodGet, pattern, handler)
+}
+
+func (g *router) POST(pattern string, handler http.HandlerFunc) {
+	g.Handle(http.MethodPost, pattern, handler)
+}
+
+func (g *router) PUT(pattern string, handler http.HandlerFunc) {
+	g.Handle(http.MethodPut, pattern, handler)
+}
+
+func (g *router) PATCH(pattern string, handler http.HandlerFunc) {
+	g.Handle(http.MethodPatch, pattern, handler)
+}
+
+func (g *router) DELETE(pattern string, handler http.HandlerFunc) {
+	g.Handle(http.MethodDelete, pattern, handler)
+}
+
+func (g *router) OPTIONS(pattern string, handler http.HandlerFunc) {
+	g.Handle(http.MethodOptions, pattern, handler)
+}
+
+func (g *router) HEAD(pattern string, handler http.HandlerFunc) {
+	g.Handle(http.MethodHead, pattern, handler)
+}
+
+func (g *router) Group(prefix string) httpserverapi.Router {
+	mws := make([]func(http.Handler) http.Handler, len(g.middlewares))
+	copy(mws, g.middlewares)
+	return &router{mux: g.mux, prefix: joinPrefix(g.prefix, prefix), middlewares: mws}
+}
+
+func (g *router) Use(mw ...func(http.Handler) http.Handler) {
+	g.middlewares = append(g.middlewares, mw...)
+}
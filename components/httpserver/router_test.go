@@ -0,0 +1,174 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gopherd/example/components/httpserver/httpserverapi"
+	"github.com/gopherd/example/components/httpserver/middleware"
+)
+
+func newTestMux() *serveMux {
+	return newServeMux()
+}
+
+func TestServeMuxStaticAndParamMatch(t *testing.T) {
+	mux := newTestMux()
+	mux.handle(http.MethodGet, "/users/:id", func(w http.ResponseWriter, r *http.Request) {
+		id := httpserverapi.ParamsFromContext(r.Context()).Get("id")
+		w.Write([]byte(id))
+	})
+	mux.handle(http.MethodGet, "/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+
+	cases := []struct {
+		path string
+		want string
+	}{
+		{"/health", "ok"},
+		{"/users/42", "42"},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d, want 200", tc.path, rec.Code)
+		}
+		if got := rec.Body.String(); got != tc.want {
+			t.Fatalf("%s: body = %q, want %q", tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestServeMuxWildcardMatch(t *testing.T) {
+	mux := newTestMux()
+	mux.handle(http.MethodGet, "/files/*path", func(w http.ResponseWriter, r *http.Request) {
+		p := httpserverapi.ParamsFromContext(r.Context()).Get("path")
+		w.Write([]byte(p))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/files/a/b/c.txt", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if got := rec.Body.String(); got != "a/b/c.txt" {
+		t.Fatalf("body = %q, want %q", got, "a/b/c.txt")
+	}
+}
+
+func TestServeMuxUnknownPathReturns404(t *testing.T) {
+	mux := newTestMux()
+	mux.handle(http.MethodGet, "/health", func(w http.ResponseWriter, r *http.Request) {})
+
+	req := httptest.NewRequest(http.MethodGet, "/nope", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404", rec.Code)
+	}
+}
+
+func TestServeMuxWrongMethodReturns405(t *testing.T) {
+	mux := newTestMux()
+	mux.handle(http.MethodGet, "/users/:id", func(w http.ResponseWriter, r *http.Request) {})
+	mux.handle(http.MethodPost, "/users/:id", func(w http.ResponseWriter, r *http.Request) {})
+
+	req := httptest.NewRequest(http.MethodDelete, "/users/42", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want 405", rec.Code)
+	}
+	if allow := rec.Header().Get("Allow"); allow != "GET, POST" {
+		t.Fatalf("Allow header = %q, want %q", allow, "GET, POST")
+	}
+}
+
+func TestRouteNodeAddPanicsOnConflictingParamName(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected add to panic on conflicting :param name at the same position")
+		}
+	}()
+	root := newRouteNode()
+	root.add(http.MethodGet, splitPath("/user/:name"), func(w http.ResponseWriter, r *http.Request) {})
+	root.add(http.MethodGet, splitPath("/user/:id"), func(w http.ResponseWriter, r *http.Request) {})
+}
+
+func TestRouteNodeAddPanicsOnConflictingWildcardName(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected add to panic on conflicting *wildcard name at the same position")
+		}
+	}()
+	root := newRouteNode()
+	root.add(http.MethodGet, splitPath("/files/*path"), func(w http.ResponseWriter, r *http.Request) {})
+	root.add(http.MethodGet, splitPath("/files/*rest"), func(w http.ResponseWriter, r *http.Request) {})
+}
+
+// TestServeMuxConcurrentRegistrationAndRequests exercises registering routes
+// (as components do from their own Start) concurrently with ServeHTTP (as the
+// listener does once it starts accepting) under the race detector.
+func TestServeMuxConcurrentRegistrationAndRequests(t *testing.T) {
+	mux := newTestMux()
+	mux.handle(http.MethodGet, "/health", func(w http.ResponseWriter, r *http.Request) {})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			mux.handle(http.MethodGet, fmt.Sprintf("/route-%d/:id", i), func(w http.ResponseWriter, r *http.Request) {})
+		}(i)
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodGet, "/health", nil)
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+		}()
+	}
+	wg.Wait()
+}
+
+// TestServerHandlerRunsMiddlewareForUnmatchedAndPreflightRequests confirms that
+// middleware wrapping the mux (rather than being chained per registered route)
+// still runs for an OPTIONS preflight to a path that only has POST registered,
+// and for a path that was never registered at all.
+func TestServerHandlerRunsMiddlewareForUnmatchedAndPreflightRequests(t *testing.T) {
+	mux := newTestMux()
+	mux.handle(http.MethodPost, "/login", func(w http.ResponseWriter, r *http.Request) {})
+
+	cors := middleware.CORS(middleware.CORSOptions{AllowedOrigins: []string{"*"}})
+	handler := chain(mux, []func(http.Handler) http.Handler{cors})
+
+	req := httptest.NewRequest(http.MethodOptions, "/login", nil)
+	req.Header.Set("Origin", "https://example.com")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("preflight status = %d, want 204", rec.Code)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://example.com" {
+		t.Fatalf("Access-Control-Allow-Origin = %q, want echoed origin", got)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/never-registered", nil)
+	req.Header.Set("Origin", "https://example.com")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("unregistered path status = %d, want 404", rec.Code)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://example.com" {
+		t.Fatalf("unregistered path Access-Control-Allow-Origin = %q, want echoed origin", got)
+	}
+}
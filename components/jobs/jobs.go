@@ -0,0 +1,124 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gopherd/core/component"
+	"github.com/gopherd/example/components/jobs/jobsapi"
+	"github.com/gopherd/example/runmode"
+)
+
+const name = "github.com/gopherd/example/components/jobs"
+
+var _ jobsapi.Component = (*jobsComponent)(nil)
+
+func init() {
+	component.Register(name, func() component.Component {
+		return new(jobsComponent)
+	})
+}
+
+type jobsComponent struct {
+	component.BaseComponent[struct {
+		Concurrency int
+		QueueSize   int
+		MaxRetries  int
+		Backoff     time.Duration
+	}]
+	queue    Queue
+	mu       sync.RWMutex
+	handlers map[string]func(context.Context, []byte) error
+	wg       sync.WaitGroup
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+func (j *jobsComponent) Init(ctx context.Context) error {
+	j.queue = newMemoryQueue(j.Options().QueueSize)
+	j.handlers = make(map[string]func(context.Context, []byte) error)
+	return nil
+}
+
+func (j *jobsComponent) Start(ctx context.Context) error {
+	if !runmode.Enabled(runmode.Job) {
+		j.Logger().Info("Jobs component disabled in current run mode")
+		return nil
+	}
+	j.Logger().Info("Starting Jobs component")
+	j.ctx, j.cancel = context.WithCancel(context.Background())
+
+	concurrency := j.Options().Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	for i := 0; i < concurrency; i++ {
+		j.wg.Add(1)
+		go j.worker()
+	}
+	return nil
+}
+
+func (j *jobsComponent) Shutdown(ctx context.Context) error {
+	if j.cancel == nil {
+		return nil
+	}
+	j.Logger().Info("Shutting down Jobs component")
+	j.cancel()
+	j.wg.Wait()
+	return nil
+}
+
+func (j *jobsComponent) Register(taskName string, handler func(context.Context, []byte) error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.handlers[taskName] = handler
+}
+
+func (j *jobsComponent) Enqueue(ctx context.Context, taskName string, payload []byte) error {
+	return j.queue.Enqueue(ctx, Task{Name: taskName, Payload: payload})
+}
+
+func (j *jobsComponent) worker() {
+	defer j.wg.Done()
+	for {
+		task, err := j.queue.Dequeue(j.ctx)
+		if err != nil {
+			return
+		}
+		j.process(task)
+	}
+}
+
+func (j *jobsComponent) process(task Task) {
+	j.mu.RLock()
+	handler, ok := j.handlers[task.Name]
+	j.mu.RUnlock()
+	if !ok {
+		j.Logger().Warn("no handler registered for job", "name", task.Name)
+		return
+	}
+
+	backoff := j.Options().Backoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+	maxRetries := j.Options().MaxRetries
+
+	for attempt := 0; ; attempt++ {
+		err := handler(j.ctx, task.Payload)
+		if err == nil {
+			return
+		}
+		j.Logger().Error("job failed", "name", task.Name, "attempt", attempt, "error", err)
+		if attempt >= maxRetries {
+			return
+		}
+		select {
+		case <-time.After(backoff * time.Duration(1<<attempt)):
+		case <-j.ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package jobsapi
+
+import "context"
+
+type Component interface {
+	// Register 为名为 name 的任务注册处理函数，Enqueue 投递的同名任务由它异步处理
+	Register(name string, handler func(ctx context.Context, payload []byte) error)
+
+	// Enqueue 把一个任务投递到队列，交由对应 name 的 handler 异步处理
+	Enqueue(ctx context.Context, name string, payload []byte) error
+}
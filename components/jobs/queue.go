@@ -0,0 +1,45 @@
+package jobs
+
+import "context"
+
+// Task 是一个排队中的任务，Name 对应 Register 时使用的处理器名字
+type Task struct {
+	Name    string
+	Payload []byte
+}
+
+// Queue 是任务队列的抽象，Redis/AliMNS 等驱动可以实现该接口接入 jobsComponent
+type Queue interface {
+	Enqueue(ctx context.Context, task Task) error
+	Dequeue(ctx context.Context) (Task, error)
+}
+
+// memoryQueue 是基于 channel 的内存队列实现
+type memoryQueue struct {
+	ch chan Task
+}
+
+func newMemoryQueue(size int) *memoryQueue {
+	if size <= 0 {
+		size = 100
+	}
+	return &memoryQueue{ch: make(chan Task, size)}
+}
+
+func (q *memoryQueue) Enqueue(ctx context.Context, task Task) error {
+	select {
+	case q.ch <- task:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *memoryQueue) Dequeue(ctx context.Context) (Task, error) {
+	select {
+	case task := <-q.ch:
+		return task, nil
+	case <-ctx.Done():
+		return Task{}, ctx.Err()
+	}
+}
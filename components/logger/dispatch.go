@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// dispatchHandler 把每条日志记录分发给所有 sink。每个 sink 的 handler 本身不做级别
+// 过滤（总是以 LevelDebug 构造），真正的阈值判断全部由 dispatchHandler 完成：
+// 默认用 sinkLevels 里记录的该 sink 配置级别，若当前组件在 Levels 中有覆盖值则
+// 以覆盖值为准——这样覆盖值既能调高也能调低该组件的有效级别。
+type dispatchHandler struct {
+	sinks      []slog.Handler
+	sinkLevels []slog.Level
+	levels     map[string]slog.Level
+
+	component string
+	override  *slog.Level
+}
+
+func newDispatchHandler(sinks []slog.Handler, sinkLevels []slog.Level, levels map[string]slog.Level) *dispatchHandler {
+	return &dispatchHandler{sinks: sinks, sinkLevels: sinkLevels, levels: levels}
+}
+
+func (h *dispatchHandler) effectiveLevel(i int) slog.Level {
+	if h.override != nil {
+		return *h.override
+	}
+	return h.sinkLevels[i]
+}
+
+func (h *dispatchHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for i := range h.sinks {
+		if level >= h.effectiveLevel(i) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *dispatchHandler) Handle(ctx context.Context, record slog.Record) error {
+	var errs []error
+	for i, sink := range h.sinks {
+		if record.Level < h.effectiveLevel(i) {
+			continue
+		}
+		if err := sink.Handle(ctx, record.Clone()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h *dispatchHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	sinks := make([]slog.Handler, len(h.sinks))
+	for i, sink := range h.sinks {
+		sinks[i] = sink.WithAttrs(attrs)
+	}
+	next := &dispatchHandler{
+		sinks:      sinks,
+		sinkLevels: h.sinkLevels,
+		levels:     h.levels,
+		component:  h.component,
+		override:   h.override,
+	}
+	for _, attr := range attrs {
+		if attr.Key == "component" {
+			next.component = attr.Value.String()
+			if level, ok := h.levels[next.component]; ok {
+				l := level
+				next.override = &l
+			}
+		}
+	}
+	return next
+}
+
+func (h *dispatchHandler) WithGroup(group string) slog.Handler {
+	sinks := make([]slog.Handler, len(h.sinks))
+	for i, sink := range h.sinks {
+		sinks[i] = sink.WithGroup(group)
+	}
+	return &dispatchHandler{
+		sinks:      sinks,
+		sinkLevels: h.sinkLevels,
+		levels:     h.levels,
+		component:  h.component,
+		override:   h.override,
+	}
+}
@@ -0,0 +1,53 @@
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+)
+
+func TestDispatchHandlerLevelOverride(t *testing.T) {
+	var buf bytes.Buffer
+	sink := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	h := newDispatchHandler([]slog.Handler{sink}, []slog.Level{slog.LevelInfo}, map[string]slog.Level{
+		"users": slog.LevelDebug,
+	})
+
+	logger := slog.New(h.WithAttrs([]slog.Attr{slog.String("component", "users")}))
+	logger.Debug("hello")
+	if buf.Len() == 0 {
+		t.Fatal("expected debug record to reach the sink when the component's Levels override lowers the threshold")
+	}
+
+	buf.Reset()
+	other := slog.New(h.WithAttrs([]slog.Attr{slog.String("component", "auth")}))
+	other.Debug("hidden")
+	if buf.Len() != 0 {
+		t.Fatalf("expected debug record to be filtered for a component without an override, got %q", buf.String())
+	}
+
+	buf.Reset()
+	other.Info("visible")
+	if buf.Len() == 0 {
+		t.Fatal("expected info record to reach the sink for a component without an override")
+	}
+}
+
+func TestDispatchHandlerRaisesThreshold(t *testing.T) {
+	var buf bytes.Buffer
+	sink := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	h := newDispatchHandler([]slog.Handler{sink}, []slog.Level{slog.LevelDebug}, map[string]slog.Level{
+		"noisy": slog.LevelWarn,
+	})
+
+	logger := slog.New(h.WithAttrs([]slog.Attr{slog.String("component", "noisy")}))
+	logger.Info("quiet please")
+	if buf.Len() != 0 {
+		t.Fatalf("expected info record to be filtered when override raises the threshold, got %q", buf.String())
+	}
+
+	logger.Warn("loud enough")
+	if buf.Len() == 0 {
+		t.Fatal("expected warn record to reach the sink")
+	}
+}
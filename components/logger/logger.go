@@ -2,10 +2,12 @@ package logger
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/gopherd/core/component"
 )
@@ -18,42 +20,129 @@ func init() {
 	})
 }
 
+// sinkConfig 描述一个日志输出目的地
+type sinkConfig struct {
+	Type       string // "file" | "stdout" | "stderr" | "syslog"
+	Path       string
+	MaxSizeMB  int
+	MaxBackups int
+	MaxAgeDays int
+	Compress   bool
+	Format     string // "json" | "text"，默认 "text"
+	Level      slog.Level
+}
+
 type loggerComponent struct {
 	component.BaseComponent[struct {
-		JSON   bool       // 是否使用 json 格式输出
-		Level  slog.Level // 日志等级
-		Output string     // 日志输出到哪里，这里简单的实现了 stderr, stdout, discard
+		Sinks []sinkConfig
+
+		// Levels 按组件名覆盖默认级别，例如 "github.com/gopherd/example/components/users": "debug"
+		Levels map[string]slog.Level
 	}]
+	handler *dispatchHandler
+	files   []*rotatingFile
+
+	sighup chan os.Signal
+	stop   chan struct{}
 }
 
 func (com *loggerComponent) Init(ctx context.Context) error {
-	output, err := com.createOutput()
-	if err != nil {
-		return err
+	opts := com.Options()
+	sinks := make([]slog.Handler, 0, len(opts.Sinks))
+	sinkLevels := make([]slog.Level, 0, len(opts.Sinks))
+	for _, sc := range opts.Sinks {
+		handler, err := com.newSinkHandler(sc)
+		if err != nil {
+			return fmt.Errorf("logger: failed to set up %q sink: %w", sc.Type, err)
+		}
+		sinks = append(sinks, handler)
+		sinkLevels = append(sinkLevels, sc.Level)
 	}
-
-	opts := &slog.HandlerOptions{
-		Level: com.Options().Level,
-	}
-	var handler slog.Handler
-	if com.Options().JSON {
-		handler = slog.NewJSONHandler(output, opts)
-	} else {
-		handler = slog.NewTextHandler(output, opts)
+	if len(sinks) == 0 {
+		sinks = append(sinks, slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		sinkLevels = append(sinkLevels, slog.LevelInfo)
 	}
-	slog.SetDefault(slog.New(handler))
+
+	com.handler = newDispatchHandler(sinks, sinkLevels, opts.Levels)
+	slog.SetDefault(slog.New(com.handler))
 	return nil
 }
 
-func (com *loggerComponent) createOutput() (io.Writer, error) {
-	switch com.Options().Output {
-	case "stderr":
-		return os.Stderr, nil
+// newSinkHandler 构造某个 sink 的 slog.Handler。这里总是以 LevelDebug 打开，
+// 让 dispatchHandler 统一决定每条记录是否达到该 sink（或按 Levels 覆盖后）的级别，
+// 否则一个组件的覆盖级别永远无法低于 sink 自身配置的级别
+func (com *loggerComponent) newSinkHandler(sc sinkConfig) (slog.Handler, error) {
+	var output io.Writer
+	switch sc.Type {
 	case "stdout":
-		return os.Stdout, nil
-	case "":
-		return io.Discard, nil
+		output = os.Stdout
+	case "stderr":
+		output = os.Stderr
+	case "file":
+		rf, err := newRotatingFile(sc.Path, sc.MaxSizeMB, sc.MaxBackups, sc.MaxAgeDays, sc.Compress)
+		if err != nil {
+			return nil, err
+		}
+		com.files = append(com.files, rf)
+		output = rf
+	case "syslog":
+		w, err := newSyslogWriter(sc.Path)
+		if err != nil {
+			return nil, err
+		}
+		output = w
 	default:
-		return nil, errors.New("unsupported output")
+		return nil, fmt.Errorf("unsupported sink type %q", sc.Type)
+	}
+
+	handlerOpts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	if sc.Format == "json" {
+		return slog.NewJSONHandler(output, handlerOpts), nil
 	}
+	return slog.NewTextHandler(output, handlerOpts), nil
+}
+
+// Start 在存在文件 sink 时监听 SIGHUP，以配合外部的 logrotate 之类的切割工具重新打开文件
+func (com *loggerComponent) Start(ctx context.Context) error {
+	if len(com.files) == 0 {
+		return nil
+	}
+	com.sighup = make(chan os.Signal, 1)
+	signal.Notify(com.sighup, syscall.SIGHUP)
+	com.stop = make(chan struct{})
+	go com.watchSighup()
+	return nil
+}
+
+func (com *loggerComponent) watchSighup() {
+	for {
+		select {
+		case <-com.sighup:
+			for _, f := range com.files {
+				if err := f.reopen(); err != nil {
+					slog.Default().Error("Failed to reopen log file", "path", f.path, "error", err)
+				}
+			}
+		case <-com.stop:
+			return
+		}
+	}
+}
+
+func (com *loggerComponent) Shutdown(ctx context.Context) error {
+	if com.stop != nil {
+		signal.Stop(com.sighup)
+		close(com.stop)
+	}
+	for _, f := range com.files {
+		f.Close()
+	}
+	return nil
+}
+
+// LoggerFor 返回一个携带 component 字段的 logger，其级别会按 Levels 配置覆盖；
+// component.BaseComponent.Logger() 通过向 slog.Default() 附加相同的 "component" 属性
+// 间接复用这里的覆盖逻辑，无需显式持有 loggerComponent 的引用
+func (com *loggerComponent) LoggerFor(name string) *slog.Logger {
+	return slog.New(com.handler.WithAttrs([]slog.Attr{slog.String("component", name)}))
 }
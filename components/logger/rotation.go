@@ -0,0 +1,169 @@
+package logger
+
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// rotatingFile 是一个按大小和时间滚动的 io.Writer，滚动时通过 rename 原子地
+// 把当前文件移动为带时间戳的备份，再打开一个新文件继续写入
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	maxAge     time.Duration
+	compress   bool
+
+	file     *os.File
+	size     int64
+	openedAt time.Time
+}
+
+func newRotatingFile(path string, maxSizeMB, maxBackups, maxAgeDays int, compress bool) (*rotatingFile, error) {
+	if path == "" {
+		return nil, fmt.Errorf("file sink requires a Path")
+	}
+	rf := &rotatingFile{
+		path:       path,
+		maxSize:    int64(maxSizeMB) * 1024 * 1024,
+		maxBackups: maxBackups,
+		maxAge:     time.Duration(maxAgeDays) * 24 * time.Hour,
+		compress:   compress,
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+func (rf *rotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(rf.path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(rf.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	rf.file = f
+	rf.size = info.Size()
+	rf.openedAt = time.Now()
+	return nil
+}
+
+func (rf *rotatingFile) Write(p []byte) (int, error) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.shouldRotate(len(p)) {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+func (rf *rotatingFile) shouldRotate(extra int) bool {
+	if rf.maxSize > 0 && rf.size+int64(extra) > rf.maxSize {
+		return true
+	}
+	if rf.maxAge > 0 && time.Since(rf.openedAt) > rf.maxAge {
+		return true
+	}
+	return false
+}
+
+// rotate 把当前文件改名为带时间戳的备份文件再打开一个新文件；调用方必须持有 rf.mu
+func (rf *rotatingFile) rotate() error {
+	if rf.file != nil {
+		rf.file.Close()
+	}
+	backup := fmt.Sprintf("%s.%s", rf.path, time.Now().Format("20060102T150405.000"))
+	if err := os.Rename(rf.path, backup); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if rf.compress {
+		go compressAndRemove(backup)
+	}
+	if err := rf.open(); err != nil {
+		return err
+	}
+	rf.pruneBackups()
+	return nil
+}
+
+// reopen 响应 SIGHUP：关闭当前句柄并重新打开同一个路径，便于外部工具先行 rename 切割
+func (rf *rotatingFile) reopen() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	if rf.file != nil {
+		rf.file.Close()
+	}
+	return rf.open()
+}
+
+func (rf *rotatingFile) pruneBackups() {
+	matches, err := filepath.Glob(rf.path + ".*")
+	if err != nil {
+		return
+	}
+	sort.Strings(matches)
+
+	if rf.maxBackups > 0 && len(matches) > rf.maxBackups {
+		for _, old := range matches[:len(matches)-rf.maxBackups] {
+			os.Remove(old)
+		}
+		matches = matches[len(matches)-rf.maxBackups:]
+	}
+	if rf.maxAge > 0 {
+		cutoff := time.Now().Add(-rf.maxAge)
+		for _, m := range matches {
+			if info, err := os.Stat(m); err == nil && info.ModTime().Before(cutoff) {
+				os.Remove(m)
+			}
+		}
+	}
+}
+
+func (rf *rotatingFile) Close() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	if rf.file != nil {
+		return rf.file.Close()
+	}
+	return nil
+}
+
+func compressAndRemove(path string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	out, err := os.Create(path + ".gz")
+	if err != nil {
+		return
+	}
+	defer out.Close()
+
+	gw := gzip.NewWriter(out)
+	if _, err := gw.Write(data); err != nil {
+		gw.Close()
+		return
+	}
+	if err := gw.Close(); err != nil {
+		return
+	}
+	os.Remove(path)
+}
@@ -0,0 +1,63 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingFileRotatesBySize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+
+	rf, err := newRotatingFile(path, 0, 0, 0, false)
+	if err != nil {
+		t.Fatalf("newRotatingFile() error = %v", err)
+	}
+	defer rf.Close()
+	rf.maxSize = 16 // force rotation well before MaxSizeMB-scale writes
+
+	if _, err := rf.Write([]byte("0123456789")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if _, err := rf.Write([]byte("0123456789")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("Glob() error = %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("expected exactly one backup after exceeding maxSize, got %d: %v", len(matches), matches)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("expected a fresh file at %q after rotation: %v", path, err)
+	}
+}
+
+func TestRotatingFilePrunesBackups(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+
+	rf, err := newRotatingFile(path, 0, 2, 0, false)
+	if err != nil {
+		t.Fatalf("newRotatingFile() error = %v", err)
+	}
+	defer rf.Close()
+	rf.maxSize = 1
+
+	for i := 0; i < 4; i++ {
+		if _, err := rf.Write([]byte("x")); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("Glob() error = %v", err)
+	}
+	if len(matches) > 2 {
+		t.Fatalf("expected at most maxBackups=2 backups, got %d: %v", len(matches), matches)
+	}
+}
@@ -2,18 +2,26 @@ package users
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/gopherd/core/component"
 	"github.com/gopherd/core/event"
 	"github.com/gopherd/example/components/auth/authapi"
+	"github.com/gopherd/example/components/cache/cacheapi"
+	"github.com/gopherd/example/components/db/dbapi"
 	"github.com/gopherd/example/components/httpserver/httpserverapi"
+	"github.com/gopherd/example/runmode"
 )
 
 const name = "github.com/gopherd/example/components/users"
 
+// loginCacheTTL 控制登录状态在 Redis 中的缓存时间，过期后回源到数据库重新确认
+const loginCacheTTL = 10 * time.Minute
+
 func init() {
 	component.Register(name, func() component.Component {
 		return new(usersComponent)
@@ -25,42 +33,105 @@ type usersComponent struct {
 		MaxUsers int
 	}, struct {
 		HTTPServer  component.Reference[httpserverapi.Component]
-		EventSystem component.Reference[event.Dispatcher[reflect.Type]]
+		Auth        component.Reference[authapi.Component]
+		EventSystem component.Reference[event.EventSystem[reflect.Type]]
+		DB          component.Reference[dbapi.Component]
+		Cache       component.Reference[cacheapi.Component]
 	}]
-	loggedInUsers map[string]bool
-}
-
-func (u *usersComponent) Init(ctx context.Context) error {
-	u.loggedInUsers = make(map[string]bool)
-	return nil
 }
 
 func (u *usersComponent) Start(ctx context.Context) error {
+	if !runmode.Enabled(runmode.API) {
+		u.Logger().Info("Users component disabled in current run mode")
+		return nil
+	}
 	u.Logger().Info("Starting Users component")
-	u.Refs().HTTPServer.Component().HandleFunc("/profile", u.handleProfile)
+	g := u.Refs().HTTPServer.Component().Group("")
+	g.Use(u.Refs().Auth.Component().Middleware())
+	g.GET("/profile", u.handleProfile)
 	u.Refs().EventSystem.Component().AddListener(authapi.LoginEventListener(u.onLoginEvent))
 	return nil
 }
 
 func (u *usersComponent) handleProfile(w http.ResponseWriter, r *http.Request) {
-	username := r.URL.Query().Get("username")
-	if username == "" {
-		http.Error(w, "Username is required", http.StatusBadRequest)
+	principal, ok := authapi.PrincipalFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
 
-	if u.loggedInUsers[username] {
-		fmt.Fprintf(w, "Profile for user: %s", username)
+	loggedIn, err := u.isLoggedIn(r.Context(), principal.Username)
+	if err != nil {
+		u.Logger().Error("Failed to check login state", "username", principal.Username, "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	if loggedIn {
+		fmt.Fprintf(w, "Profile for user: %s", principal.Username)
 	} else {
 		http.Error(w, "User not logged in", http.StatusUnauthorized)
 	}
 }
 
+// isLoggedIn 先查 Redis 缓存，未命中时回源到从库并回填缓存
+func (u *usersComponent) isLoggedIn(ctx context.Context, username string) (bool, error) {
+	cache := u.Refs().Cache.Component()
+	if value, err := cache.Get(ctx, loginCacheKey(username)); err == nil && value != "" {
+		return true, nil
+	}
+
+	var marker int
+	row := u.Refs().DB.Component().Replica().QueryRowContext(ctx,
+		"SELECT 1 FROM user_logins WHERE username = ?", username)
+	switch err := row.Scan(&marker); err {
+	case nil:
+		if err := cache.Set(ctx, loginCacheKey(username), "1", loginCacheTTL); err != nil {
+			u.Logger().Warn("Failed to warm login cache", "username", username, "error", err)
+		}
+		return true, nil
+	case sql.ErrNoRows:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (u *usersComponent) onLoginEvent(ctx context.Context, e *authapi.LoginEvent) error {
 	u.Logger().Info("User logged in", "username", e.Username)
-	u.loggedInUsers[e.Username] = true
-	if len(u.loggedInUsers) > u.Options().MaxUsers {
+
+	err := u.Refs().DB.Component().WithTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO user_logins (username, logged_in_at) VALUES (?, ?) "+
+				"ON DUPLICATE KEY UPDATE logged_in_at = VALUES(logged_in_at)",
+			e.Username, time.Now())
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("users: failed to record login for %q: %w", e.Username, err)
+	}
+
+	cache := u.Refs().Cache.Component()
+	if err := cache.Set(ctx, loginCacheKey(e.Username), "1", loginCacheTTL); err != nil {
+		u.Logger().Warn("Failed to warm login cache", "username", e.Username, "error", err)
+	}
+
+	if count, err := u.countLoggedInUsers(ctx); err != nil {
+		u.Logger().Warn("Failed to count logged in users", "error", err)
+	} else if count > u.Options().MaxUsers {
 		u.Logger().Warn("Warning: Too many users logged")
 	}
 	return nil
 }
+
+func (u *usersComponent) countLoggedInUsers(ctx context.Context) (int, error) {
+	var count int
+	row := u.Refs().DB.Component().Replica().QueryRowContext(ctx, "SELECT COUNT(*) FROM user_logins")
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func loginCacheKey(username string) string {
+	return "users:logged_in:" + username
+}
@@ -0,0 +1,26 @@
+// Package runmode 定义了进程启动时通过 -mode 选择的运行模式。
+// 各组件共享同一份配置文件，再由 Enabled 判断自己是否应该在当前模式下真正工作，
+// 这样 api/cron/job 可以拆分成不同的运行模式，也可以在 all 模式下一起跑在同一个进程里。
+package runmode
+
+// Mode 标识服务运行的模式
+type Mode string
+
+const (
+	API  Mode = "api"
+	Cron Mode = "cron"
+	Job  Mode = "job"
+	All  Mode = "all"
+)
+
+var current Mode = All
+
+// Set 设置当前进程的运行模式，应在组件 Start 之前、尽早调用
+func Set(m Mode) {
+	current = m
+}
+
+// Enabled 返回 m 对应的组件在当前运行模式下是否应该工作
+func Enabled(m Mode) bool {
+	return current == All || current == m
+}